@@ -0,0 +1,218 @@
+// Copyright ©2016 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat64
+
+import "math"
+
+// BandCholesky is a type for creating and using the Cholesky factorization
+// of a symmetric positive definite band matrix.
+//
+// For an n×n matrix with bandwidth kd, BandCholesky computes the upper
+// triangular factor U such that A = U^T * U, where U has the same
+// bandwidth kd as A, in O(n*kd^2) time and O(n*kd) memory. This is a large
+// improvement over the O(n^3) time and O(n^2) memory of the dense Cholesky
+// for the narrow-banded systems produced by finite-difference, spline and
+// other 1-D discretizations.
+type BandCholesky struct {
+	n, kd, stride int
+	// factor holds the upper-triangular band factor U in the same layout
+	// as SymBandDense's Upper storage: factor[r*stride+(j-r)] is U[r,j]
+	// for r <= j <= r+kd.
+	factor []float64
+	cond   float64
+}
+
+// Factorize calculates the Cholesky decomposition of the symmetric positive
+// definite band matrix A and returns whether A is positive definite. If
+// Factorize returns false, the factorization must not be used.
+func (c *BandCholesky) Factorize(a *SymBandDense) (ok bool) {
+	n, kd := a.mat.N, a.mat.K
+	c.n, c.kd, c.stride = n, kd, kd+1
+	c.factor = make([]float64, n*c.stride)
+
+	at := func(r, j int) float64 { return c.factor[r*c.stride+(j-r)] }
+	set := func(r, j int, v float64) { c.factor[r*c.stride+(j-r)] = v }
+
+	for i := 0; i < n; i++ {
+		hi := i + kd
+		if hi >= n {
+			hi = n - 1
+		}
+		for j := i; j <= hi; j++ {
+			set(i, j, a.at(i, j))
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		lo := j - kd
+		if lo < 0 {
+			lo = 0
+		}
+		var d float64
+		for k := lo; k < j; k++ {
+			s := at(k, j)
+			d += s * s
+		}
+		diag := at(j, j) - d
+		if diag <= 0 {
+			c.factor = nil
+			c.cond = math.Inf(1)
+			return false
+		}
+		ujj := math.Sqrt(diag)
+		set(j, j, ujj)
+
+		hi := j + kd
+		if hi >= n {
+			hi = n - 1
+		}
+		for i := j + 1; i <= hi; i++ {
+			lo2 := i - kd
+			if lo2 < lo {
+				lo2 = lo
+			}
+			var s float64
+			for k := lo2; k < j; k++ {
+				s += at(k, j) * at(k, i)
+			}
+			set(j, i, (at(j, i)-s)/ujj)
+		}
+	}
+	c.cond = c.calcCond(a)
+	return true
+}
+
+// Cond returns the condition number of the factorized matrix, estimated as
+// κ₁(A) = ‖A‖₁ · ‖A⁻¹‖₁. Cond must be called after a successful call to
+// Factorize, otherwise it panics.
+func (c *BandCholesky) Cond() float64 {
+	if c.factor == nil {
+		panic("mat64: cholesky not factorized")
+	}
+	return c.cond
+}
+
+// Det returns the determinant of the matrix that has been factorized.
+func (c *BandCholesky) Det() float64 {
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been
+// factorized.
+func (c *BandCholesky) LogDet() float64 {
+	var det float64
+	for i := 0; i < c.n; i++ {
+		det += 2 * math.Log(c.factor[i*c.stride])
+	}
+	return det
+}
+
+// solveVec solves A * x = b in place, overwriting b, using the banded
+// factor U, A = U^T * U.
+func (c *BandCholesky) solveVec(b []float64) {
+	n, kd := c.n, c.kd
+	at := func(r, j int) float64 { return c.factor[r*c.stride+(j-r)] }
+
+	// Forward solve U^T * y = b.
+	for i := 0; i < n; i++ {
+		lo := i - kd
+		if lo < 0 {
+			lo = 0
+		}
+		var s float64
+		for k := lo; k < i; k++ {
+			s += at(k, i) * b[k]
+		}
+		b[i] = (b[i] - s) / at(i, i)
+	}
+	// Back solve U * x = y.
+	for i := n - 1; i >= 0; i-- {
+		hi := i + kd
+		if hi >= n {
+			hi = n - 1
+		}
+		var s float64
+		for k := i + 1; k <= hi; k++ {
+			s += at(i, k) * b[k]
+		}
+		b[i] = (b[i] - s) / at(i, i)
+	}
+}
+
+// calcCond estimates κ₁(A) = ‖A‖₁ · ‖A⁻¹‖₁, in the same manner as
+// (*Cholesky).calcCond but summing only over the stored band for ‖A‖₁.
+func (c *BandCholesky) calcCond(a *SymBandDense) float64 {
+	n, kd := c.n, c.kd
+	var normA float64
+	for j := 0; j < n; j++ {
+		lo := j - kd
+		if lo < 0 {
+			lo = 0
+		}
+		hi := j + kd
+		if hi >= n {
+			hi = n - 1
+		}
+		var colSum float64
+		for i := lo; i <= hi; i++ {
+			colSum += math.Abs(a.at(i, j))
+		}
+		if colSum > normA {
+			normA = colSum
+		}
+	}
+	if normA == 0 {
+		return math.Inf(1)
+	}
+	return normA * hagerNorm1Inv(n, c.solveVec)
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the band Cholesky decomposition, placing the result into dst.
+func (c *BandCholesky) SolveTo(dst *Dense, b Matrix) {
+	n := c.n
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+	dst.reuseAs(bm, bn)
+	if b != Matrix(dst) {
+		dst.Copy(b)
+	}
+
+	col := make([]float64, n)
+	for j := 0; j < bn; j++ {
+		for i := 0; i < n; i++ {
+			col[i] = dst.at(i, j)
+		}
+		c.solveVec(col)
+		for i := 0; i < n; i++ {
+			dst.set(i, j, col[i])
+		}
+	}
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is
+// represented by the band Cholesky decomposition, placing the result into
+// dst.
+func (c *BandCholesky) SolveVecTo(dst *Vector, b *Vector) {
+	n := c.n
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	if dst != b {
+		dst.reuseAs(n)
+		dst.CopyVec(b)
+	}
+
+	col := make([]float64, n)
+	for i := 0; i < n; i++ {
+		col[i] = dst.At(i, 0)
+	}
+	c.solveVec(col)
+	for i := 0; i < n; i++ {
+		dst.Set(i, 0, col[i])
+	}
+}
@@ -15,11 +15,436 @@ import (
 
 const badTriangle = "mat64: invalid triangle"
 
-// Cholesky calculates the Cholesky decomposition of the matrix A and returns
-// whether the matrix is positive definite. The returned matrix is either a
-// lower triangular matrix such that A = L * L^T or an upper triangular matrix
-// such that A = U^T * U depending on the upper parameter.
-func (t *TriDense) Cholesky(a *SymDense, upper bool) (ok bool) {
+// Cholesky is a type for creating and using the Cholesky factorization of a
+// symmetric positive definite matrix.
+//
+// Cholesky factorizes a symmetric positive definite matrix A into
+//  A = U^T * U
+// where U is upper triangular. The factorization is cached so that it can be
+// reused to solve additional systems, compute the determinant, or compute the
+// inverse of A without repeating the O(n^3) decomposition.
+type Cholesky struct {
+	// chol holds the triangular factor U such that A = U^T * U. It is
+	// always stored with an Upper Uplo.
+	chol *TriDense
+	cond float64
+}
+
+// Factorize calculates the Cholesky decomposition of the matrix A and
+// returns whether A is positive definite. If Factorize returns false, the
+// factorization must not be used.
+func (c *Cholesky) Factorize(a Symmetric) (ok bool) {
+	if c.chol == nil {
+		c.chol = &TriDense{}
+	} else {
+		c.chol.Reset()
+	}
+	ok = cholesky(c.chol, symDenseOf(a), true)
+	if !ok {
+		c.cond = math.Inf(1)
+		return false
+	}
+	c.cond = c.calcCond(a)
+	return true
+}
+
+// symDenseOf returns a as a *SymDense, copying its data if a is not already
+// one.
+func symDenseOf(a Symmetric) *SymDense {
+	if sd, ok := a.(*SymDense); ok {
+		return sd
+	}
+	n := a.Symmetric()
+	sd := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			sd.SetSym(i, j, a.At(i, j))
+		}
+	}
+	return sd
+}
+
+// Cond returns the condition number of the factorized matrix, estimated as
+// κ₁(A) = ‖A‖₁ · ‖A⁻¹‖₁. Cond panics if Factorize has not been called, or if
+// the factorization was last produced by SymRankOne or ExtendVecSym. After a
+// failed Factorize, Cond returns +Inf.
+func (c *Cholesky) Cond() float64 {
+	if c.chol == nil {
+		panic("mat64: cholesky not factorized")
+	}
+	if math.IsNaN(c.cond) {
+		panic("mat64: condition number unavailable after SymRankOne or ExtendVecSym; call Factorize to recompute it")
+	}
+	return c.cond
+}
+
+// LTo extracts the lower triangular factor L such that A = L * L^T from a
+// Cholesky decomposition into dst. If dst is empty, LTo will resize dst to
+// be n×n. When dst is non-empty, LTo will panic if dst is not n×n.
+func (c *Cholesky) LTo(dst *TriDense) {
+	n := c.chol.mat.N
+	if dst.isZero() {
+		dst.mat = blas64.Triangular{
+			N:      n,
+			Stride: n,
+			Diag:   blas.NonUnit,
+			Uplo:   blas.Lower,
+			Data:   use(dst.mat.Data, n*n),
+		}
+	} else if n != dst.mat.N {
+		panic(ErrShape)
+	} else {
+		dst.mat.Uplo = blas.Lower
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			dst.set(i, j, c.chol.at(j, i))
+		}
+	}
+}
+
+// UTo extracts the upper triangular factor U such that A = U^T * U from a
+// Cholesky decomposition into dst. If dst is empty, UTo will resize dst to
+// be n×n. When dst is non-empty, UTo will panic if dst is not n×n.
+func (c *Cholesky) UTo(dst *TriDense) {
+	n := c.chol.mat.N
+	if dst.isZero() {
+		dst.mat = blas64.Triangular{
+			N:      n,
+			Stride: n,
+			Diag:   blas.NonUnit,
+			Uplo:   blas.Upper,
+			Data:   use(dst.mat.Data, n*n),
+		}
+	} else if n != dst.mat.N {
+		panic(ErrShape)
+	} else {
+		dst.mat.Uplo = blas.Upper
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			dst.set(i, j, c.chol.at(i, j))
+		}
+	}
+}
+
+// ToSym reconstructs the original positive definite matrix A = U^T * U from
+// a Cholesky decomposition into dst, overwriting the previous value of dst.
+// If dst is empty, ToSym will resize dst to be n×n.
+func (c *Cholesky) ToSym(dst *SymDense) {
+	n := c.chol.mat.N
+	if dst.IsZero() {
+		dst.reuseAs(n)
+	} else if n != dst.mat.N {
+		panic(ErrShape)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			var v float64
+			for k := 0; k <= i; k++ {
+				v += c.chol.at(k, i) * c.chol.at(k, j)
+			}
+			dst.SetSym(i, j, v)
+		}
+	}
+}
+
+// Det returns the determinant of the matrix that has been factorized.
+func (c *Cholesky) Det() float64 {
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been
+// factorized.
+func (c *Cholesky) LogDet() float64 {
+	var det float64
+	n := c.chol.mat.N
+	for i := 0; i < n; i++ {
+		det += 2 * math.Log(c.chol.at(i, i))
+	}
+	return det
+}
+
+// solveVec solves A * x = b in place, overwriting b, using the cached
+// Cholesky factor U, A = U^T * U.
+func (c *Cholesky) solveVec(b []float64) {
+	bv := blas64.Vector{Inc: 1, Data: b}
+	blas64.Trsv(blas.Trans, c.chol.mat, bv)
+	blas64.Trsv(blas.NoTrans, c.chol.mat, bv)
+}
+
+// calcCond estimates κ₁(A) = ‖A‖₁ · ‖A⁻¹‖₁. ‖A‖₁ is computed directly as the
+// maximum absolute column sum, and ‖A⁻¹‖₁ is estimated with Hager's 1-norm
+// estimator (as used by LAPACK's DLACON/DLACN2), using repeated triangular
+// solves against the cached factor.
+func (c *Cholesky) calcCond(a Symmetric) float64 {
+	n := c.chol.mat.N
+	var normA float64
+	for j := 0; j < n; j++ {
+		var colSum float64
+		for i := 0; i < n; i++ {
+			colSum += math.Abs(a.At(i, j))
+		}
+		if colSum > normA {
+			normA = colSum
+		}
+	}
+	if normA == 0 {
+		return math.Inf(1)
+	}
+	return normA * hagerNorm1Inv(n, c.solveVec)
+}
+
+// hagerNorm1Inv estimates ‖A⁻¹‖₁ for the n×n matrix A using Hager's 1-norm
+// estimator (as used by LAPACK's DLACON/DLACN2), calling solveVec to solve
+// A * x = b in place, overwriting b, for a sequence of right-hand sides.
+func hagerNorm1Inv(n int, solveVec func(b []float64)) float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	var est, estOld float64
+	for iter := 0; iter < 5; iter++ {
+		solveVec(x)
+		est = 0
+		for _, v := range x {
+			est += math.Abs(v)
+		}
+		for i, v := range x {
+			if v >= 0 {
+				x[i] = 1
+			} else {
+				x[i] = -1
+			}
+		}
+		solveVec(x)
+
+		j := 0
+		big := math.Abs(x[0])
+		for i := 1; i < n; i++ {
+			if v := math.Abs(x[i]); v > big {
+				big, j = v, i
+			}
+		}
+		if iter > 0 && est <= estOld {
+			break
+		}
+		estOld = est
+		for i := range x {
+			x[i] = 0
+		}
+		x[j] = 1
+	}
+	return est
+}
+
+// InverseTo computes the inverse of the matrix that has been factorized and
+// stores the result into dst. Because the inverse of a symmetric positive
+// definite matrix is itself symmetric positive definite, dst is a *SymDense.
+func (c *Cholesky) InverseTo(dst *SymDense) {
+	n := c.chol.mat.N
+	ident := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		ident.Set(i, i, 1)
+	}
+	c.SolveTo(ident, ident)
+	if dst.IsZero() {
+		dst.reuseAs(n)
+	} else if n != dst.mat.N {
+		panic(ErrShape)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			dst.SetSym(i, j, ident.at(i, j))
+		}
+	}
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented by
+// the Cholesky decomposition, placing the result into dst.
+func (c *Cholesky) SolveTo(dst *Dense, b Matrix) {
+	solveCholeskyTo(dst, b, c.chol.mat)
+}
+
+// solveCholeskyTo finds the matrix X that solves A * X = B where A = L * L^T
+// or A = U^T * U and U or L are represented by a, placing the result into
+// dst. It underlies both Cholesky.SolveTo and Dense.SolveCholesky.
+func solveCholeskyTo(dst *Dense, b Matrix, a blas64.Triangular) {
+	bm, bn := b.Dims()
+	if a.N != bm {
+		panic(ErrShape)
+	}
+	dst.reuseAs(bm, bn)
+	if b != Matrix(dst) {
+		dst.Copy(b)
+	}
+	switch a.Uplo {
+	case blas.Upper:
+		blas64.Trsm(blas.Left, blas.Trans, 1, a, dst.mat)
+		blas64.Trsm(blas.Left, blas.NoTrans, 1, a, dst.mat)
+	case blas.Lower:
+		blas64.Trsm(blas.Left, blas.NoTrans, 1, a, dst.mat)
+		blas64.Trsm(blas.Left, blas.Trans, 1, a, dst.mat)
+	default:
+		panic(badTriangle)
+	}
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is
+// represented by the Cholesky decomposition, placing the result into dst.
+func (c *Cholesky) SolveVecTo(dst *Vector, b *Vector) {
+	n := c.chol.mat.N
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	if dst != b {
+		dst.reuseAs(n)
+		dst.CopyVec(b)
+	}
+	blas64.Trsv(blas.Trans, c.chol.mat, dst.mat)
+	blas64.Trsv(blas.NoTrans, c.chol.mat, dst.mat)
+}
+
+// SymRankOne updates the Cholesky factorization in orig so that it becomes
+// the factorization of A ± alpha*x*x^T, where A is the matrix that orig
+// factorizes, and stores the result into the receiver, which may be orig
+// itself to update in place. The update is done in O(n^2) rather than
+// refactorizing from scratch.
+//
+// If alpha is negative, the update is a downdate, which may cause the
+// matrix to lose its positive definiteness. In that case SymRankOne
+// returns false and leaves the receiver unchanged.
+func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x *Vector) (ok bool) {
+	n := orig.chol.mat.N
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+
+	// The rotation is computed into a scratch copy of the factor, not into
+	// c.chol directly: c may be orig itself (the common in-place call),
+	// and a downdate can fail partway through after some rows have already
+	// been rewritten. Only commit to the receiver once every row is known
+	// to be positive definite, so a failed downdate truly leaves the
+	// receiver unchanged, as documented.
+	scratch := make([]float64, n*n)
+	at := func(i, j int) float64 { return scratch[i*n+j] }
+	set := func(i, j int, v float64) { scratch[i*n+j] = v }
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			set(i, j, orig.chol.at(i, j))
+		}
+	}
+
+	aAlpha := math.Sqrt(math.Abs(alpha))
+	if aAlpha != 0 {
+		// work holds x throughout, rescaled at each step so that
+		// aAlpha*work always equals the z vector of the standard
+		// Givens/hyperbolic recurrence (Golub & Van Loan); dividing the
+		// correction term by aAlpha keeps that invariant.
+		work := make([]float64, n)
+		for i := 0; i < n; i++ {
+			work[i] = x.At(i, 0)
+		}
+
+		if alpha >= 0 {
+			// Givens-rotation update.
+			for j := 0; j < n; j++ {
+				ujj := at(j, j)
+				r := math.Hypot(ujj, aAlpha*work[j])
+				cj := r / ujj
+				sj := aAlpha * work[j] / ujj
+				set(j, j, r)
+				for i := j + 1; i < n; i++ {
+					uji := (at(j, i) + sj*aAlpha*work[i]) / cj
+					work[i] = cj*work[i] - sj*uji/aAlpha
+					set(j, i, uji)
+				}
+			}
+		} else {
+			// Hyperbolic-rotation downdate.
+			for j := 0; j < n; j++ {
+				ujj := at(j, j)
+				d := ujj*ujj - aAlpha*aAlpha*work[j]*work[j]
+				if d <= 0 {
+					return false
+				}
+				r := math.Sqrt(d)
+				cj := r / ujj
+				sj := aAlpha * work[j] / ujj
+				set(j, j, r)
+				for i := j + 1; i < n; i++ {
+					uji := (at(j, i) - sj*aAlpha*work[i]) / cj
+					work[i] = cj*work[i] - sj*uji/aAlpha
+					set(j, i, uji)
+				}
+			}
+		}
+	}
+
+	if c.chol == nil || c.chol.mat.N != n {
+		c.chol = NewTriDense(n, true, nil)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			c.chol.set(i, j, at(i, j))
+		}
+	}
+	// Cond() panics until a fresh Factorize recomputes the estimate; the
+	// O(n^2) update does not recompute the condition number.
+	c.cond = math.NaN()
+	return true
+}
+
+// ExtendVecSym grows the n×n Cholesky factorization in orig to an
+// (n+1)×(n+1) factorization and stores the result into the receiver, which
+// may be orig itself. The new row/column of the symmetric positive definite
+// matrix is given by a, where a[i] for i < n is the cross term with
+// existing row/column i, and a[n] is the new diagonal entry. ExtendVecSym
+// returns false, leaving the receiver unchanged, if the extended matrix is
+// not positive definite.
+func (c *Cholesky) ExtendVecSym(orig *Cholesky, a *Vector) (ok bool) {
+	n := orig.chol.mat.N
+	if a.Len() != n+1 {
+		panic(ErrShape)
+	}
+
+	z := make([]float64, n)
+	for i := 0; i < n; i++ {
+		z[i] = a.At(i, 0)
+	}
+	// Solve L*z = a[:n], where L = U^T is the lower Cholesky factor.
+	zv := blas64.Vector{Inc: 1, Data: z}
+	blas64.Trsv(blas.Trans, orig.chol.mat, zv)
+
+	var sq float64
+	for _, v := range z {
+		sq += v * v
+	}
+	d := a.At(n, 0) - sq
+	if d <= 0 {
+		return false
+	}
+
+	t := NewTriDense(n+1, true, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			t.set(i, j, orig.chol.at(i, j))
+		}
+		t.set(i, n, z[i])
+	}
+	t.set(n, n, math.Sqrt(d))
+
+	c.chol = t
+	c.cond = math.NaN()
+	return true
+}
+
+// cholesky performs the Cholesky factorization of a into t, storing either
+// the upper triangular factor U such that a = U^T * U, or the lower
+// triangular factor L such that a = L * L^T, depending on upper. It uses the
+// unblocked algorithm for n <= choleskyBlockedMin and the blocked algorithm
+// otherwise.
+func cholesky(t *TriDense, a *SymDense, upper bool) (ok bool) {
 	n := a.Symmetric()
 	if t.isZero() {
 		t.mat = blas64.Triangular{
@@ -31,11 +456,56 @@ func (t *TriDense) Cholesky(a *SymDense, upper bool) (ok bool) {
 	} else if n != t.mat.N {
 		panic(ErrShape)
 	}
+	if upper {
+		t.mat.Uplo = blas.Upper
+	} else {
+		t.mat.Uplo = blas.Lower
+	}
+
+	// Copy a into t; the elimination below proceeds in place on t, reading
+	// each entry exactly once, before it is overwritten by the factor.
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			if upper {
+				t.set(i, j, a.at(i, j))
+			} else {
+				t.set(j, i, a.at(j, i))
+			}
+		}
+	}
+
+	if n <= choleskyBlockedMin {
+		return choleskyUnblocked(t, upper)
+	}
+	return choleskyBlocked(t, upper)
+}
+
+// choleskyBlockedMin is the matrix order at or below which the unblocked,
+// column-by-column Cholesky algorithm is used in preference to the blocked
+// algorithm. Below this size the overhead of the blocked algorithm's
+// Level-3 BLAS calls outweighs the benefit of improved cache behaviour.
+const choleskyBlockedMin = 64
+
+// choleskyBlockSize returns the panel width to use for a blocked Cholesky
+// factorization of an n×n matrix, mirroring the heuristic used by LAPACK's
+// DPOTRF.
+func choleskyBlockSize(n int) int {
+	const nb = 64
+	if nb > n {
+		return n
+	}
+	return nb
+}
+
+// choleskyUnblocked performs the unblocked, column-by-column Cholesky
+// factorization of t in place, where t already holds the matrix to be
+// factorized in its upper or lower triangle.
+func choleskyUnblocked(t *TriDense, upper bool) (ok bool) {
+	n := t.mat.N
 	mat := t.mat.Data
 	stride := t.mat.Stride
 
 	if upper {
-		t.mat.Uplo = blas.Upper
 		for j := 0; j < n; j++ {
 			var d float64
 			for k := 0; k < j; k++ {
@@ -45,11 +515,11 @@ func (t *TriDense) Cholesky(a *SymDense, upper bool) (ok bool) {
 					uintptr(stride), uintptr(stride),
 					uintptr(k), uintptr(j),
 				)
-				s = (a.at(j, k) - s) / t.at(k, k)
+				s = (t.at(k, j) - s) / t.at(k, k)
 				t.set(k, j, s)
 				d += s * s
 			}
-			d = a.at(j, j) - d
+			d = t.at(j, j) - d
 			if d <= 0 {
 				t.Reset()
 				return false
@@ -57,16 +527,15 @@ func (t *TriDense) Cholesky(a *SymDense, upper bool) (ok bool) {
 			t.set(j, j, math.Sqrt(math.Max(d, 0)))
 		}
 	} else {
-		t.mat.Uplo = blas.Lower
 		for j := 0; j < n; j++ {
 			var d float64
 			for k := 0; k < j; k++ {
 				s := asm.DdotUnitary(mat[k*stride:k*stride+k], mat[j*stride:j*stride+k])
-				s = (a.at(j, k) - s) / t.at(k, k)
+				s = (t.at(j, k) - s) / t.at(k, k)
 				t.set(j, k, s)
 				d += s * s
 			}
-			d = a.at(j, j) - d
+			d = t.at(j, j) - d
 			if d <= 0 {
 				t.Reset()
 				return false
@@ -78,35 +547,116 @@ func (t *TriDense) Cholesky(a *SymDense, upper bool) (ok bool) {
 	return true
 }
 
-// SolveCholesky finds the matrix x that solves A * X = B where A = L * L^T or
-// A = U^T * U, and U or L are represented by t. The matrix A must be symmetric
-// and positive definite.
-func (m *Dense) SolveCholesky(t Triangular, b Matrix) {
-	_, n := t.Dims()
-	bm, bn := b.Dims()
-	if n != bm {
-		panic(ErrShape)
+// choleskyBlocked performs a blocked Cholesky factorization of t in place,
+// mirroring LAPACK's DPOTRF. It panels the matrix into blocks of width nb,
+// factorizing each diagonal block with choleskyUnblocked and using Level-3
+// BLAS (Syrk, Gemm, Trsm) to apply the accumulated updates to the rest of
+// the matrix, which is significantly more cache efficient than the
+// unblocked algorithm for large n.
+func choleskyBlocked(t *TriDense, upper bool) (ok bool) {
+	n := t.mat.N
+	stride := t.mat.Stride
+	mat := t.mat.Data
+	nb := choleskyBlockSize(n)
+
+	if upper {
+		for j := 0; j < n; j += nb {
+			jb := nb
+			if j+jb > n {
+				jb = n - j
+			}
+			diag := blas64.Triangular{
+				N: jb, Stride: stride, Uplo: blas.Upper, Diag: blas.NonUnit,
+				Data: mat[j*stride+j:],
+			}
+			if j > 0 {
+				panel := blas64.General{Rows: j, Cols: jb, Stride: stride, Data: mat[j:]}
+				diagSym := blas64.Symmetric{N: jb, Stride: stride, Uplo: blas.Upper, Data: mat[j*stride+j:]}
+				blas64.Syrk(blas.Trans, -1, panel, 1, diagSym)
+			}
+			diagT := &TriDense{mat: diag}
+			if !choleskyUnblocked(diagT, true) {
+				t.Reset()
+				return false
+			}
+			if rest := n - j - jb; rest > 0 {
+				trailing := blas64.General{Rows: jb, Cols: rest, Stride: stride, Data: mat[j*stride+j+jb:]}
+				if j > 0 {
+					left := blas64.General{Rows: j, Cols: jb, Stride: stride, Data: mat[j:]}
+					right := blas64.General{Rows: j, Cols: rest, Stride: stride, Data: mat[j+jb:]}
+					blas64.Gemm(blas.Trans, blas.NoTrans, -1, left, right, 1, trailing)
+				}
+				blas64.Trsm(blas.Left, blas.Trans, 1, diagT.mat, trailing)
+			}
+		}
+	} else {
+		for j := 0; j < n; j += nb {
+			jb := nb
+			if j+jb > n {
+				jb = n - j
+			}
+			diag := blas64.Triangular{
+				N: jb, Stride: stride, Uplo: blas.Lower, Diag: blas.NonUnit,
+				Data: mat[j*stride+j:],
+			}
+			if j > 0 {
+				panel := blas64.General{Rows: jb, Cols: j, Stride: stride, Data: mat[j*stride:]}
+				diagSym := blas64.Symmetric{N: jb, Stride: stride, Uplo: blas.Lower, Data: mat[j*stride+j:]}
+				blas64.Syrk(blas.NoTrans, -1, panel, 1, diagSym)
+			}
+			diagT := &TriDense{mat: diag}
+			if !choleskyUnblocked(diagT, false) {
+				t.Reset()
+				return false
+			}
+			if rest := n - j - jb; rest > 0 {
+				trailing := blas64.General{Rows: rest, Cols: jb, Stride: stride, Data: mat[(j+jb)*stride+j:]}
+				if j > 0 {
+					below := blas64.General{Rows: rest, Cols: j, Stride: stride, Data: mat[(j+jb)*stride:]}
+					left := blas64.General{Rows: jb, Cols: j, Stride: stride, Data: mat[j*stride:]}
+					blas64.Gemm(blas.NoTrans, blas.Trans, -1, below, left, 1, trailing)
+				}
+				blas64.Trsm(blas.Right, blas.Trans, 1, diagT.mat, trailing)
+			}
+		}
 	}
 
-	m.reuseAs(bm, bn)
-	if b != m {
-		m.Copy(b)
+	return true
+}
+
+// Cholesky calculates the Cholesky decomposition of the matrix A and returns
+// whether the matrix is positive definite. The returned matrix is either a
+// lower triangular matrix such that A = L * L^T or an upper triangular matrix
+// such that A = U^T * U depending on the upper parameter.
+//
+// Cholesky is a thin wrapper around the Cholesky type; new code should
+// prefer using Cholesky directly since it caches the factorization and
+// exposes Det, InverseTo and other derived quantities without recomputing.
+func (t *TriDense) Cholesky(a *SymDense, upper bool) (ok bool) {
+	var c Cholesky
+	if !c.Factorize(a) {
+		return false
+	}
+	if upper {
+		c.UTo(t)
+	} else {
+		c.LTo(t)
 	}
+	return true
+}
 
+// SolveCholesky finds the matrix x that solves A * X = B where A = L * L^T or
+// A = U^T * U, and U or L are represented by t. The matrix A must be symmetric
+// and positive definite.
+//
+// SolveCholesky is a thin wrapper around solveCholeskyTo, the same
+// triangular-solve logic used by Cholesky.SolveTo, kept for backward
+// compatibility with callers that already have a triangular factor rather
+// than a Cholesky.
+func (m *Dense) SolveCholesky(t Triangular, b Matrix) {
 	// TODO(btracey): Implement an algorithm that doesn't require a copy into
 	// a blas64.Triangular.
-	ta := getBlasTriangular(t)
-
-	switch ta.Uplo {
-	case blas.Upper:
-		blas64.Trsm(blas.Left, blas.Trans, 1, ta, m.mat)
-		blas64.Trsm(blas.Left, blas.NoTrans, 1, ta, m.mat)
-	case blas.Lower:
-		blas64.Trsm(blas.Left, blas.NoTrans, 1, ta, m.mat)
-		blas64.Trsm(blas.Left, blas.Trans, 1, ta, m.mat)
-	default:
-		panic(badTriangle)
-	}
+	solveCholeskyTo(m, b, getBlasTriangular(t))
 }
 
 // SolveTri finds the matrix x that solves op(A) * X = B where A is a triangular
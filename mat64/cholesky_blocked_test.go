@@ -0,0 +1,103 @@
+// Copyright ©2016 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat64
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonum/blas"
+	"github.com/gonum/blas/blas64"
+)
+
+// makeSPD builds an n×n SPD matrix A = M^T*M + n*I for a fixed, deterministic
+// M, which is diagonally dominant enough to stay SPD for any n used here.
+func makeSPD(n int) *SymDense {
+	m := make([]float64, n*n)
+	for i := range m {
+		m[i] = math.Sin(float64(i)) // deterministic, no math/rand dependency
+	}
+	mm := NewDense(n, n, m)
+
+	var mtm Dense
+	mtm.Mul(mm.T(), mm)
+
+	a := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := mtm.At(i, j)
+			if i == j {
+				v += float64(n)
+			}
+			a.SetSym(i, j, v)
+		}
+	}
+	return a
+}
+
+func TestCholeskyBlocked(t *testing.T) {
+	// n is chosen well above choleskyBlockedMin so Factorize takes the
+	// blocked code path.
+	n := choleskyBlockedMin + 10
+	a := makeSPD(n)
+
+	var chol Cholesky
+	if !chol.Factorize(a) {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	var got SymDense
+	chol.ToSym(&got)
+	var maxDiff float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if diff := math.Abs(got.at(i, j) - a.at(i, j)); diff > maxDiff {
+				maxDiff = diff
+			}
+		}
+	}
+	if maxDiff > 1e-6 {
+		t.Errorf("blocked factorization reconstruction error too large: %v", maxDiff)
+	}
+}
+
+// TestCholeskyBlockedMatchesUnblocked checks that the blocked and unblocked
+// kernels agree on the same matrix, above the size where Factorize
+// switches from one to the other.
+func TestCholeskyBlockedMatchesUnblocked(t *testing.T) {
+	n := choleskyBlockedMin + 10
+	a := makeSPD(n)
+
+	blocked := &TriDense{}
+	if !cholesky(blocked, a, true) {
+		t.Fatal("blocked cholesky returned false for an SPD matrix")
+	}
+
+	unblocked := &TriDense{
+		mat: blas64.Triangular{
+			N:      n,
+			Stride: n,
+			Diag:   blas.NonUnit,
+			Uplo:   blas.Upper,
+			Data:   make([]float64, n*n),
+		},
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			unblocked.set(i, j, a.at(i, j))
+		}
+	}
+	if !choleskyUnblocked(unblocked, true) {
+		t.Fatal("unblocked cholesky returned false for an SPD matrix")
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			if diff := math.Abs(blocked.at(i, j) - unblocked.at(i, j)); diff > 1e-8 {
+				t.Errorf("blocked/unblocked mismatch at (%d,%d): got %v, want %v", i, j, blocked.at(i, j), unblocked.at(i, j))
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright ©2016 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat64
+
+import (
+	"math"
+	"testing"
+)
+
+// spdTestData returns a small, fixed symmetric positive definite matrix and
+// its known determinant, used across the Cholesky tests.
+func spdTestData() (n int, data []float64, det float64) {
+	// A = [[4, 2, 0], [2, 5, 1], [0, 1, 3]] is SPD with det = 44.
+	return 3, []float64{
+		4, 2, 0,
+		2, 5, 1,
+		0, 1, 3,
+	}, 44
+}
+
+func TestCholeskyFactorize(t *testing.T) {
+	n, data, wantDet := spdTestData()
+	a := NewSymDense(n, data)
+
+	var chol Cholesky
+	if ok := chol.Factorize(a); !ok {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	var got SymDense
+	chol.ToSym(&got)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if diff := math.Abs(got.at(i, j) - a.at(i, j)); diff > 1e-10 {
+				t.Errorf("ToSym mismatch at (%d,%d): got %v, want %v", i, j, got.at(i, j), a.at(i, j))
+			}
+		}
+	}
+
+	if diff := math.Abs(chol.Det() - wantDet); diff > 1e-8 {
+		t.Errorf("Det: got %v, want %v", chol.Det(), wantDet)
+	}
+	if diff := math.Abs(chol.LogDet() - math.Log(wantDet)); diff > 1e-8 {
+		t.Errorf("LogDet: got %v, want %v", chol.LogDet(), math.Log(wantDet))
+	}
+
+	var inv SymDense
+	chol.InverseTo(&inv)
+	var identity Dense
+	identity.Mul(a, &inv)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if diff := math.Abs(identity.at(i, j) - want); diff > 1e-8 {
+				t.Errorf("A*InverseTo mismatch at (%d,%d): got %v, want %v", i, j, identity.at(i, j), want)
+			}
+		}
+	}
+}
+
+func TestCholeskyCond(t *testing.T) {
+	n, data, _ := spdTestData()
+	a := NewSymDense(n, data)
+
+	var chol Cholesky
+	if !chol.Factorize(a) {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	// Hand-computed from A's adjugate: ‖A‖₁ = 8, ‖A⁻¹‖₁ = 22/44, so
+	// κ₁(A) = ‖A‖₁ * ‖A⁻¹‖₁ = 176/44.
+	want := 176.0 / 44.0
+	if diff := math.Abs(chol.Cond() - want); diff > 1e-8 {
+		t.Errorf("Cond: got %v, want %v", chol.Cond(), want)
+	}
+}
+
+func TestCholeskyNotPositiveDefinite(t *testing.T) {
+	a := NewSymDense(2, []float64{1, 2, 2, 1})
+	var chol Cholesky
+	if chol.Factorize(a) {
+		t.Error("Factorize returned true for a non-positive-definite matrix")
+	}
+}
+
+func TestCholeskySolveTo(t *testing.T) {
+	n, data, _ := spdTestData()
+	a := NewSymDense(n, data)
+
+	var chol Cholesky
+	if !chol.Factorize(a) {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	b := NewDense(n, 1, []float64{1, 2, 3})
+	var x Dense
+	chol.SolveTo(&x, b)
+
+	var ax Dense
+	ax.Mul(a, &x)
+	for i := 0; i < n; i++ {
+		if diff := math.Abs(ax.at(i, 0) - b.at(i, 0)); diff > 1e-8 {
+			t.Errorf("A*x mismatch at row %d: got %v, want %v", i, ax.at(i, 0), b.at(i, 0))
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright ©2016 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat64
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCholeskySymRankOne(t *testing.T) {
+	n, data, _ := spdTestData()
+	a := NewSymDense(n, data)
+
+	var orig Cholesky
+	if !orig.Factorize(a) {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	for _, alpha := range []float64{0.7, 2.3, 1} {
+		x := NewVector(n, []float64{1, -0.5, 2})
+
+		var updated Cholesky
+		if !updated.SymRankOne(&orig, alpha, x) {
+			t.Fatalf("SymRankOne(alpha=%v) unexpectedly reported failure", alpha)
+		}
+
+		var got SymDense
+		updated.ToSym(&got)
+
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				want := a.at(i, j) + alpha*x.At(i, 0)*x.At(j, 0)
+				if diff := math.Abs(got.at(i, j) - want); diff > 1e-8 {
+					t.Errorf("alpha=%v: SymRankOne mismatch at (%d,%d): got %v, want %v", alpha, i, j, got.at(i, j), want)
+				}
+			}
+		}
+	}
+}
+
+func TestCholeskySymRankOneDowndate(t *testing.T) {
+	n, data, _ := spdTestData()
+	a := NewSymDense(n, data)
+
+	var orig Cholesky
+	if !orig.Factorize(a) {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	alpha := -0.1
+	x := NewVector(n, []float64{1, -0.5, 2})
+
+	var updated Cholesky
+	if !updated.SymRankOne(&orig, alpha, x) {
+		t.Fatal("SymRankOne downdate unexpectedly reported failure")
+	}
+
+	var got SymDense
+	updated.ToSym(&got)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			want := a.at(i, j) + alpha*x.At(i, 0)*x.At(j, 0)
+			if diff := math.Abs(got.at(i, j) - want); diff > 1e-8 {
+				t.Errorf("downdate mismatch at (%d,%d): got %v, want %v", i, j, got.at(i, j), want)
+			}
+		}
+	}
+}
+
+func TestCholeskySymRankOneDowndateFailure(t *testing.T) {
+	n, data, _ := spdTestData()
+	a := NewSymDense(n, data)
+
+	var orig Cholesky
+	if !orig.Factorize(a) {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	// This downdate drives the matrix indefinite partway through the
+	// recurrence (the third diagonal goes non-positive), so it must fail.
+	alpha := -0.5
+	x := NewVector(n, []float64{0.1, 0.1, 3.0})
+
+	// Call in place, chol.SymRankOne(chol, ...), as a caller updating its
+	// own factorization would.
+	var chol Cholesky
+	chol.Factorize(a)
+	if chol.SymRankOne(&chol, alpha, x) {
+		t.Fatal("SymRankOne downdate unexpectedly reported success")
+	}
+
+	// The receiver was updated in place; it must be left exactly as it was
+	// before the call, not partially rewritten.
+	var got, want SymDense
+	chol.ToSym(&got)
+	orig.ToSym(&want)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if got.at(i, j) != want.at(i, j) {
+				t.Errorf("receiver mutated by failed downdate at (%d,%d): got %v, want %v", i, j, got.at(i, j), want.at(i, j))
+			}
+		}
+	}
+}
+
+func TestCholeskyExtendVecSym(t *testing.T) {
+	n, data, _ := spdTestData()
+	a := NewSymDense(n, data)
+
+	var orig Cholesky
+	if !orig.Factorize(a) {
+		t.Fatal("Factorize returned false for an SPD matrix")
+	}
+
+	// Extend by a new row/column [1, 0, 2, 6].
+	newRow := []float64{1, 0, 2, 6}
+	av := NewVector(n+1, newRow)
+
+	var ext Cholesky
+	if !ext.ExtendVecSym(&orig, av) {
+		t.Fatal("ExtendVecSym unexpectedly reported failure")
+	}
+
+	full := NewSymDense(n+1, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			full.SetSym(i, j, a.at(i, j))
+		}
+	}
+	for i := 0; i <= n; i++ {
+		full.SetSym(i, n, newRow[i])
+	}
+
+	var got SymDense
+	ext.ToSym(&got)
+	for i := 0; i <= n; i++ {
+		for j := 0; j <= n; j++ {
+			if diff := math.Abs(got.at(i, j) - full.at(i, j)); diff > 1e-8 {
+				t.Errorf("ExtendVecSym mismatch at (%d,%d): got %v, want %v", i, j, got.at(i, j), full.at(i, j))
+			}
+		}
+	}
+}
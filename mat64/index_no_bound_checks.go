@@ -106,6 +106,53 @@ func (s *SymDense) set(r, c int, v float64) {
 	s.mat.Data[r*s.mat.Stride+c] = v
 }
 
+// At returns the element at row r, column c.
+func (s *SymBandDense) At(r, c int) float64 {
+	if r >= s.mat.N || r < 0 {
+		panic(ErrRowAccess)
+	}
+	if c >= s.mat.N || c < 0 {
+		panic(ErrColAccess)
+	}
+	return s.at(r, c)
+}
+
+func (s *SymBandDense) at(r, c int) float64 {
+	if r > c {
+		r, c = c, r
+	}
+	if c-r > s.mat.K {
+		return 0
+	}
+	return s.mat.Data[r*s.mat.Stride+(c-r)]
+}
+
+// SetSymBand sets the elements at (r,c) and (c,r) to the value v. It panics
+// if the location falls outside the stored band.
+func (s *SymBandDense) SetSymBand(r, c int, v float64) {
+	if r >= s.mat.N || r < 0 {
+		panic(ErrRowAccess)
+	}
+	if c >= s.mat.N || c < 0 {
+		panic(ErrColAccess)
+	}
+	d := c - r
+	if d < 0 {
+		d = -d
+	}
+	if d > s.mat.K {
+		panic(badBandwidth)
+	}
+	s.set(r, c, v)
+}
+
+func (s *SymBandDense) set(r, c int, v float64) {
+	if r > c {
+		r, c = c, r
+	}
+	s.mat.Data[r*s.mat.Stride+(c-r)] = v
+}
+
 // At returns the element at row r, column c.
 func (t *TriDense) At(r, c int) float64 {
 	if r >= t.mat.N || r < 0 {
@@ -0,0 +1,173 @@
+// Copyright ©2016 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat64
+
+import "math"
+
+// PivotedCholesky is a type for creating and using the pivoted Cholesky
+// factorization of a symmetric positive semi-definite matrix.
+//
+// PivotedCholesky factorizes a symmetric positive semi-definite matrix A
+// into
+//  P^T * A * P = L * L^T
+// where P is a permutation and L is an n×rank lower trapezoidal matrix.
+// Unlike Cholesky, PivotedCholesky does not require A to be positive
+// definite: factorization stops as soon as the remaining diagonal residual
+// is negligible, producing a low-rank factor whose rank reveals the
+// numerical rank of A. This is useful for low-rank approximations of
+// kernel matrices, where A is only positive semi-definite in principle.
+type PivotedCholesky struct {
+	// l is stored in step (pivot) order, not original row order: l.at(i, k)
+	// is the entry for the row that occupies pivot position i, so that l
+	// itself is genuinely lower trapezoidal and l.at(0:n, 0:rank) directly
+	// equals L in P^T * A * P = L * L^T.
+	l    *Dense // n×n, only the first rank columns are populated
+	piv  []int
+	rank int
+	n    int
+}
+
+// Factorize computes the pivoted Cholesky factorization of a, selecting the
+// next pivot at each step as the largest remaining diagonal residual and
+// terminating once that residual falls at or below tol times the largest
+// residual seen. It returns the numerical rank of a revealed by this
+// stopping criterion.
+func (p *PivotedCholesky) Factorize(a Symmetric, tol float64) (rank int) {
+	n := a.Symmetric()
+	p.n = n
+	p.l = NewDense(n, n, nil)
+	p.piv = make([]int, n)
+	for i := range p.piv {
+		p.piv[i] = i
+	}
+
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = a.At(i, i)
+	}
+
+	var d0 float64
+	k := 0
+	for ; k < n; k++ {
+		j := k
+		for i := k + 1; i < n; i++ {
+			if d[i] > d[j] {
+				j = i
+			}
+		}
+		if k == 0 {
+			d0 = d[j]
+		}
+		if d[j] <= tol*d0 {
+			break
+		}
+
+		p.piv[k], p.piv[j] = p.piv[j], p.piv[k]
+		d[k], d[j] = d[j], d[k]
+		// Swap the already-computed columns of the partial factor along
+		// with piv and d, so that l stays in step order and row k is
+		// final once this iteration completes.
+		for s := 0; s < k; s++ {
+			lk, lj := p.l.at(k, s), p.l.at(j, s)
+			p.l.set(k, s, lj)
+			p.l.set(j, s, lk)
+		}
+
+		pk := p.piv[k]
+		lkk := math.Sqrt(d[k])
+		p.l.set(k, k, lkk)
+		for i := k + 1; i < n; i++ {
+			pi := p.piv[i]
+			var s float64
+			for t := 0; t < k; t++ {
+				s += p.l.at(i, t) * p.l.at(k, t)
+			}
+			lik := (a.At(pi, pk) - s) / lkk
+			p.l.set(i, k, lik)
+			d[i] -= lik * lik
+		}
+	}
+	p.rank = k
+	return k
+}
+
+// Rank returns the numerical rank found by the most recent call to
+// Factorize.
+func (p *PivotedCholesky) Rank() int {
+	return p.rank
+}
+
+// PermutationTo stores the permutation matrix P used in the factorization
+// P^T * A * P = L * L^T into dst, resizing it if necessary.
+func (p *PivotedCholesky) PermutationTo(dst *Dense) {
+	n := p.n
+	dst.reuseAs(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			dst.set(i, j, 0)
+		}
+	}
+	for col, row := range p.piv {
+		dst.set(row, col, 1)
+	}
+}
+
+// LTo extracts the n×rank lower trapezoidal factor L into dst, resizing it
+// if necessary.
+func (p *PivotedCholesky) LTo(dst *Dense) {
+	dst.reuseAs(p.n, p.rank)
+	for i := 0; i < p.n; i++ {
+		for j := 0; j < p.rank; j++ {
+			dst.set(i, j, p.l.at(i, j))
+		}
+	}
+}
+
+// SolveTo finds an approximate least-squares solution of A * X = B using
+// only the rank-revealing part of the truncated factorization, placing the
+// result into dst. Directions discarded by the rank truncation are treated
+// as the null space of A and contribute zero to the solution.
+func (p *PivotedCholesky) SolveTo(dst *Dense, b Matrix) {
+	n, r := p.n, p.rank
+	_, bc := b.Dims()
+	dst.reuseAs(n, bc)
+
+	pb := NewDense(n, bc, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < bc; j++ {
+			pb.set(i, j, b.At(p.piv[i], j))
+		}
+	}
+
+	for col := 0; col < bc; col++ {
+		// Forward solve L[:r,:r] * y = (P^T b)[:r]; l is already in step
+		// order, so no piv indirection is needed here.
+		for i := 0; i < r; i++ {
+			var s float64
+			for k := 0; k < i; k++ {
+				s += p.l.at(i, k) * pb.at(k, col)
+			}
+			pb.set(i, col, (pb.at(i, col)-s)/p.l.at(i, i))
+		}
+		// Back solve L[:r,:r]^T * x = y.
+		for i := r - 1; i >= 0; i-- {
+			var s float64
+			for k := i + 1; k < r; k++ {
+				s += p.l.at(k, i) * pb.at(k, col)
+			}
+			pb.set(i, col, (pb.at(i, col)-s)/p.l.at(i, i))
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < bc; j++ {
+			if i < r {
+				dst.set(p.piv[i], j, pb.at(i, j))
+			} else {
+				dst.set(p.piv[i], j, 0)
+			}
+		}
+	}
+}
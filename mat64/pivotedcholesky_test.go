@@ -0,0 +1,68 @@
+// Copyright ©2016 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat64
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPivotedCholeskyFullRank(t *testing.T) {
+	n, data, _ := spdTestData()
+	a := NewSymDense(n, data)
+
+	var pc PivotedCholesky
+	rank := pc.Factorize(a, 1e-12)
+	if rank != n {
+		t.Fatalf("Factorize rank = %d, want %d for a full-rank SPD matrix", rank, n)
+	}
+
+	var perm, l Dense
+	pc.PermutationTo(&perm)
+	pc.LTo(&l)
+
+	// Check L is genuinely lower trapezoidal: column j has zero entries for
+	// rows i < j.
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < rank; j++ {
+			if l.At(i, j) != 0 {
+				t.Errorf("L is not lower trapezoidal: L[%d,%d] = %v, want 0", i, j, l.At(i, j))
+			}
+		}
+	}
+
+	// P^T * A * P should equal L * L^T.
+	var pt, ap, ptap, llt Dense
+	pt.Clone(perm.T())
+	ap.Mul(a, &perm)
+	ptap.Mul(&pt, &ap)
+	llt.Mul(&l, l.T())
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if diff := math.Abs(ptap.At(i, j) - llt.At(i, j)); diff > 1e-8 {
+				t.Errorf("P^T*A*P != L*L^T at (%d,%d): got %v, want %v", i, j, llt.At(i, j), ptap.At(i, j))
+			}
+		}
+	}
+}
+
+func TestPivotedCholeskyRankDeficient(t *testing.T) {
+	// A rank-1 matrix x*x^T is positive semi-definite with rank 1.
+	x := []float64{1, 2, 3}
+	n := len(x)
+	a := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, x[i]*x[j])
+		}
+	}
+
+	var pc PivotedCholesky
+	rank := pc.Factorize(a, 1e-10)
+	if rank != 1 {
+		t.Fatalf("Factorize rank = %d, want 1 for a rank-1 matrix", rank)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright ©2016 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat64
+
+import (
+	"github.com/gonum/blas"
+	"github.com/gonum/blas/blas64"
+)
+
+const badBandwidth = "mat64: bandwidth out of range"
+
+// SymBandDense represents a symmetric matrix in band storage. Only the
+// diagonal and up to K super-diagonals are held, in upper layout, giving
+// O(n*K) storage instead of the O(n^2) required by SymDense. This is a
+// natural representation for the banded systems that arise from
+// finite-difference discretizations, splines and other 1-D problems.
+type SymBandDense struct {
+	mat blas64.SymmetricBand
+}
+
+// NewSymBandDense creates a new SymBandDense symmetric band matrix with n
+// rows and columns and bandwidth kd, the number of super-diagonals (or
+// equivalently sub-diagonals) stored alongside the main diagonal. If data
+// is nil, a new slice is allocated for the backing data. If data is not
+// nil, it must hold n*(kd+1) elements and is used as the backing slice.
+func NewSymBandDense(n, kd int, data []float64) *SymBandDense {
+	if n <= 0 || kd < 0 {
+		panic(ErrZeroLength)
+	}
+	if kd+1 > n {
+		panic(badBandwidth)
+	}
+	if data != nil && len(data) != n*(kd+1) {
+		panic(ErrShape)
+	}
+	if data == nil {
+		data = make([]float64, n*(kd+1))
+	}
+	return &SymBandDense{
+		mat: blas64.SymmetricBand{
+			N:      n,
+			K:      kd,
+			Stride: kd + 1,
+			Uplo:   blas.Upper,
+			Data:   data,
+		},
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (s *SymBandDense) Dims() (r, c int) {
+	return s.mat.N, s.mat.N
+}
+
+// Symmetric returns the size of the receiver.
+func (s *SymBandDense) Symmetric() int {
+	return s.mat.N
+}
+
+// T returns the receiver, since the transpose of a symmetric matrix is
+// itself.
+func (s *SymBandDense) T() Matrix {
+	return s
+}
+
+// Bandwidth returns the number of super-diagonals and sub-diagonals stored,
+// which for a symmetric band matrix are equal.
+func (s *SymBandDense) Bandwidth() (kl, ku int) {
+	return s.mat.K, s.mat.K
+}